@@ -0,0 +1,504 @@
+// Command gitops-tui is the interactive menu for the gitops library: a
+// thin REPL that prompts for input and drives a gitops.Client, so the same
+// operations remain usable from a terminal even though gitops itself is
+// just a library now.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops"
+	"github.com/Charuchandra-12/my_random_scripts/gitops/patch"
+	"github.com/Charuchandra-12/my_random_scripts/i18n"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorBlue   = "\033[34m"
+	colorPurple = "\033[35m"
+	colorCyan   = "\033[36m"
+)
+
+// tui wraps a gitops.Client with the scanner and prompts the interactive
+// menu needs; all the actual git work happens in the Client.
+type tui struct {
+	client  *gitops.Client
+	logger  gitops.Logger
+	scanner *bufio.Scanner
+}
+
+func newTUI() *tui {
+	logger := gitops.NewConsoleLogger()
+	return &tui{
+		client:  gitops.New(gitops.WithLogger(logger)),
+		logger:  logger,
+		scanner: bufio.NewScanner(os.Stdin),
+	}
+}
+
+func (t *tui) prompt(message string) string {
+	fmt.Printf("%s%s:%s ", colorCyan, message, colorReset)
+	t.scanner.Scan()
+	return strings.TrimSpace(t.scanner.Text())
+}
+
+func (t *tui) logInfo(format string, args ...any) {
+	t.logger.Info(format, args...)
+}
+
+func (t *tui) logWarn(format string, args ...any) {
+	t.logger.Warn(format, args...)
+}
+
+func (t *tui) showMenu() {
+	fmt.Printf("\n%s=== %s ===%s\n", colorBlue, i18n.Tr("Git Operations Tool"), colorReset)
+	fmt.Println(i18n.Tr("1.  Clone Repository"))
+	fmt.Println(i18n.Tr("2.  Initialize Repository"))
+	fmt.Println(i18n.Tr("3.  Add Files"))
+	fmt.Println(i18n.Tr("4.  Commit Changes"))
+	fmt.Println(i18n.Tr("5.  Push Changes"))
+	fmt.Println(i18n.Tr("6.  Pull Changes"))
+	fmt.Println(i18n.Tr("7.  Create Branch"))
+	fmt.Println(i18n.Tr("8.  Switch Branch"))
+	fmt.Println(i18n.Tr("9.  Merge Branch"))
+	fmt.Println(i18n.Tr("10. Delete Branch"))
+	fmt.Println(i18n.Tr("11. Show Status"))
+	fmt.Println(i18n.Tr("12. Show Log"))
+	fmt.Println(i18n.Tr("13. Show Branches"))
+	fmt.Println(i18n.Tr("14. Show Remotes"))
+	fmt.Println(i18n.Tr("15. Add Remote"))
+	fmt.Println(i18n.Tr("16. Fetch"))
+	fmt.Println(i18n.Tr("17. Reset"))
+	fmt.Println(i18n.Tr("18. Stash"))
+	fmt.Println(i18n.Tr("19. Apply/Drop Stash"))
+	fmt.Println(i18n.Tr("20. Show Diff"))
+	fmt.Println(i18n.Tr("21. Stage Lines"))
+	fmt.Println(i18n.Tr("22. Unstage Lines"))
+	fmt.Println(i18n.Tr("0.  Exit"))
+	fmt.Printf("%s=========================%s\n", colorBlue, colorReset)
+}
+
+func (t *tui) handleClone() error {
+	url := t.prompt(i18n.Tr("Enter repository URL"))
+	if url == "" {
+		return fmt.Errorf("repository URL is required")
+	}
+	dir := t.prompt(i18n.Tr("Enter directory name (optional)"))
+	return t.client.Clone(url, dir)
+}
+
+func (t *tui) handleInit() error {
+	dir := t.prompt(i18n.Tr("Enter directory path (. for current)"))
+	if dir == "" {
+		dir = "."
+	}
+	return t.client.Init(dir)
+}
+
+func (t *tui) handleAdd() error {
+	files := t.prompt(i18n.Tr("Enter files to add (. for all)"))
+	if files == "" {
+		files = "."
+	}
+
+	paths := strings.Fields(files)
+	if err := t.client.Add(paths...); err != nil {
+		return err
+	}
+	t.logInfo("Staged %[1]d path(s)", len(paths))
+	return nil
+}
+
+func (t *tui) handleCommit() error {
+	message := t.prompt(i18n.Tr("Enter commit message"))
+	if message == "" {
+		return fmt.Errorf("commit message is required")
+	}
+
+	if err := t.client.Commit(message); err != nil {
+		if gitops.IsNothingToCommit(err) {
+			t.logWarn("Nothing to commit. Use \"Add Files\" to stage changes first.")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (t *tui) handlePush() error {
+	remote := t.prompt(i18n.Tr("Enter remote name (default: origin)"))
+	if remote == "" {
+		remote = "origin"
+	}
+	branch := t.prompt(i18n.Tr("Enter branch name (leave empty for current)"))
+
+	err := t.client.Push(remote, branch)
+	if gitops.IsNonFastForward(err) {
+		t.logWarn("Push rejected as non-fast-forward. Try \"Pull Changes\" (rebase) or stash your work first.")
+	}
+	return err
+}
+
+func (t *tui) handlePull() error {
+	remote := t.prompt(i18n.Tr("Enter remote name (default: origin)"))
+	if remote == "" {
+		remote = "origin"
+	}
+	branch := t.prompt(i18n.Tr("Enter branch name (leave empty for current)"))
+	return t.client.Pull(remote, branch)
+}
+
+func (t *tui) handleCreateBranch() error {
+	name := t.prompt(i18n.Tr("Enter new branch name"))
+	if name == "" {
+		return fmt.Errorf("branch name is required")
+	}
+	checkout := t.prompt(i18n.Tr("Checkout new branch? (y/n)"))
+	return t.client.CreateBranch(name, strings.ToLower(checkout) == "y")
+}
+
+// pickBranch lists local branches and lets the user pick one by number,
+// falling back to a typed name if they enter non-numeric input.
+func (t *tui) pickBranch(prompt string) (string, error) {
+	branches, err := t.client.Branches()
+	if err != nil {
+		return "", err
+	}
+
+	for i, b := range branches {
+		marker := " "
+		if b.IsHead {
+			marker = "*"
+		}
+		fmt.Printf("%s%2d. %s %s\n", marker, i+1, b.Name, colorYellow+b.Upstream+colorReset)
+	}
+
+	choice := t.prompt(prompt)
+	if idx, err := strconv.Atoi(choice); err == nil {
+		if idx < 1 || idx > len(branches) {
+			return "", fmt.Errorf("no such branch number: %d", idx)
+		}
+		return branches[idx-1].Name, nil
+	}
+	return choice, nil
+}
+
+func (t *tui) handleSwitchBranch() error {
+	name, err := t.pickBranch(i18n.Tr("Select branch number or enter a name"))
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("branch name is required")
+	}
+	return t.client.SwitchBranch(name)
+}
+
+func (t *tui) handleMerge() error {
+	branch := t.prompt(i18n.Tr("Enter branch to merge"))
+	if branch == "" {
+		return fmt.Errorf("branch name is required")
+	}
+	return t.client.Merge(branch)
+}
+
+func (t *tui) handleDeleteBranch() error {
+	branch, err := t.pickBranch(i18n.Tr("Select branch number to delete"))
+	if err != nil {
+		return err
+	}
+	if branch == "" {
+		return fmt.Errorf("branch name is required")
+	}
+
+	force := t.prompt(i18n.Tr("Force delete? (y/n)"))
+	return t.client.DeleteBranch(branch, strings.ToLower(force) == "y")
+}
+
+func (t *tui) handleAddRemote() error {
+	name := t.prompt(i18n.Tr("Enter remote name"))
+	if name == "" {
+		return fmt.Errorf("remote name is required")
+	}
+	url := t.prompt(i18n.Tr("Enter remote URL"))
+	if url == "" {
+		return fmt.Errorf("remote URL is required")
+	}
+	return t.client.AddRemote(name, url)
+}
+
+func (t *tui) handleReset() error {
+	mode := t.prompt(i18n.Tr("Enter reset mode (soft/mixed/hard)"))
+	commit := t.prompt(i18n.Tr("Enter commit hash (HEAD for last commit)"))
+	return t.client.Reset(strings.ToLower(mode), commit)
+}
+
+func (t *tui) handleStash() error {
+	message := t.prompt(i18n.Tr("Enter stash message (optional)"))
+	return t.client.Stash(message)
+}
+
+// showBranches lists local branches with their upstream and HEAD marker.
+func (t *tui) showBranches() error {
+	branches, err := t.client.Branches()
+	if err != nil {
+		return err
+	}
+	for i, b := range branches {
+		marker := " "
+		if b.IsHead {
+			marker = "*"
+		}
+		fmt.Printf("%s%2d. %s -> %s (%s)\n", marker, i+1, b.Name, b.Upstream, b.Commit[:7])
+	}
+	return nil
+}
+
+// showLog lists the last 10 commits with author and date.
+func (t *tui) showLog() error {
+	commits, err := t.client.Log(10)
+	if err != nil {
+		return err
+	}
+	for _, c := range commits {
+		date := time.Unix(c.Time, 0).Format("2006-01-02 15:04")
+		fmt.Printf("%s  %-7s %s (%s)\n", date, c.Hash[:7], c.Subject, c.Author)
+	}
+	return nil
+}
+
+// showRemotes lists configured remotes by name and URL.
+func (t *tui) showRemotes() error {
+	remotes, err := t.client.Remotes()
+	if err != nil {
+		return err
+	}
+	for i, r := range remotes {
+		fmt.Printf("%2d. %s -> %s\n", i+1, r.Name, r.URL)
+	}
+	return nil
+}
+
+// showStash lists stash entries, then offers to apply or drop one by index.
+func (t *tui) showStash() error {
+	entries, err := t.client.StashList()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		t.logInfo("No stash entries")
+		return nil
+	}
+	for i, e := range entries {
+		fmt.Printf("%2d. %s  %s\n", i+1, e.Ref, e.Message)
+	}
+
+	choice := t.prompt(i18n.Tr("Enter index to act on (blank to skip)"))
+	if choice == "" {
+		return nil
+	}
+	idx, err := strconv.Atoi(choice)
+	if err != nil || idx < 1 || idx > len(entries) {
+		return fmt.Errorf("no such stash index: %s", choice)
+	}
+	ref := entries[idx-1].Ref
+
+	action := t.prompt(i18n.Tr("apply or drop?"))
+	if strings.ToLower(action) == "drop" {
+		return t.client.DropStash(ref)
+	}
+	return t.client.ApplyStash(ref)
+}
+
+// handleStageLines lets the user stage individual +/- lines of a file's
+// unstaged diff, rather than the whole file.
+func (t *tui) handleStageLines() error {
+	return t.stageLines(false)
+}
+
+// handleUnstageLines lets the user unstage individual +/- lines that are
+// currently staged.
+func (t *tui) handleUnstageLines() error {
+	return t.stageLines(true)
+}
+
+func (t *tui) stageLines(cached bool) error {
+	path := t.prompt(i18n.Tr("Enter file path"))
+	if path == "" {
+		return fmt.Errorf("file path is required")
+	}
+
+	parser := patch.NewPatchParser(t.client.Runner(), t.client.Dir())
+	fp, err := parser.Parse(path, cached)
+	if err != nil {
+		return err
+	}
+	if len(fp.Hunks) == 0 {
+		t.logInfo("No changes to stage in %[1]s", path)
+		return nil
+	}
+
+	total := displayChangeLines(fp)
+	selection := t.prompt(i18n.Tr("Select line numbers to stage (e.g. 1-3,5)"))
+	selected, err := parseLineSelection(selection, total)
+	if err != nil {
+		return err
+	}
+
+	manager := patch.NewPatchManager()
+	manager.Select(fp, selected)
+	combined := manager.Combined()
+	if strings.TrimSpace(combined) == "" {
+		t.logInfo("Nothing selected, no changes staged")
+		return nil
+	}
+
+	return t.client.ApplyPatch(combined, cached)
+}
+
+// displayChangeLines prints every +/- line of fp numbered in the order the
+// diff produced them, and returns how many there are.
+func displayChangeLines(fp *patch.FilePatch) int {
+	n := 0
+	for _, h := range fp.Hunks {
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case patch.Add:
+				n++
+				fmt.Printf("%4d: %s+ %s%s\n", n, colorGreen, l.Text, colorReset)
+			case patch.Del:
+				n++
+				fmt.Printf("%4d: %s- %s%s\n", n, colorRed, l.Text, colorReset)
+			}
+		}
+	}
+	return n
+}
+
+// parseLineSelection parses comma-separated "start-end" ranges or single
+// numbers, checked with the same bounds rules as FileEditor's copyLines,
+// into a set of 1-based indices translated to the 0-based change indices
+// PatchModifier expects.
+func parseLineSelection(input string, total int) (map[int]bool, error) {
+	selected := make(map[int]bool)
+	for _, part := range strings.Split(input, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var start, end int
+		if strings.Contains(part, "-") {
+			bounds := strings.SplitN(part, "-", 2)
+			s, err1 := strconv.Atoi(strings.TrimSpace(bounds[0]))
+			e, err2 := strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid range: %s", part)
+			}
+			start, end = s, e
+		} else {
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid selection: %s", part)
+			}
+			start, end = n, n
+		}
+
+		if start < 1 || end > total || start > end {
+			return nil, fmt.Errorf("invalid line range: %s", part)
+		}
+		for i := start; i <= end; i++ {
+			selected[i-1] = true
+		}
+	}
+	return selected, nil
+}
+
+func (t *tui) run() {
+	t.logInfo("Git Operations Tool started")
+
+	for {
+		t.showMenu()
+		choice := t.prompt(i18n.Tr("Select operation"))
+
+		var err error
+
+		switch choice {
+		case "1":
+			err = t.handleClone()
+		case "2":
+			err = t.handleInit()
+		case "3":
+			err = t.handleAdd()
+		case "4":
+			err = t.handleCommit()
+		case "5":
+			err = t.handlePush()
+		case "6":
+			err = t.handlePull()
+		case "7":
+			err = t.handleCreateBranch()
+		case "8":
+			err = t.handleSwitchBranch()
+		case "9":
+			err = t.handleMerge()
+		case "10":
+			err = t.handleDeleteBranch()
+		case "11":
+			err = t.client.Status()
+		case "12":
+			err = t.showLog()
+		case "13":
+			err = t.showBranches()
+		case "14":
+			err = t.showRemotes()
+		case "15":
+			err = t.handleAddRemote()
+		case "16":
+			err = t.client.Fetch()
+		case "17":
+			err = t.handleReset()
+		case "18":
+			err = t.handleStash()
+		case "19":
+			err = t.showStash()
+		case "20":
+			err = t.client.Diff()
+		case "21":
+			err = t.handleStageLines()
+		case "22":
+			err = t.handleUnstageLines()
+		case "0":
+			t.logInfo("Exiting Git Operations Tool")
+			return
+		default:
+			t.logWarn("Invalid choice. Please try again.")
+			continue
+		}
+
+		if err != nil {
+			t.logger.Error("Operation failed: %[1]v", err)
+		}
+
+		fmt.Printf("\n%s%s%s", colorPurple, i18n.Tr("Press Enter to continue..."), colorReset)
+		t.scanner.Scan()
+	}
+}
+
+func main() {
+	if _, err := exec.LookPath("git"); err != nil {
+		log.Fatal(i18n.Tr("Git is not installed or not in PATH"))
+	}
+
+	newTUI().run()
+}
@@ -2,18 +2,34 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/Charuchandra-12/my_random_scripts/i18n"
 )
 
+// logInfo prints an already-translated message with an "INFO:" prefix,
+// matching the log.Printf("INFO: ...") style the rest of the tool used
+// before i18n. Callers must pass a literal key straight to i18n.Tr at the
+// call site (e.g. logInfo(i18n.Tr("Inserted line at %[1]d", lineNum))) so
+// the po/default.pot extractor, which only matches literal i18n.Tr/TrN
+// arguments, can see it — passing a format string through this function
+// would hide it from extraction.
+func logInfo(message string) {
+	log.Printf("%s %s", i18n.Tr("INFO:"), message)
+}
+
 type FileEditor struct {
-	filename string
-	lines    []string
-	modified bool
+	filename  string
+	lines     []string
+	modified  bool
+	undoStack []undoOp
+	redoStack []undoOp
 }
 
 func NewFileEditor(filename string) (*FileEditor, error) {
@@ -32,7 +48,7 @@ func NewFileEditor(filename string) (*FileEditor, error) {
 
 func (fe *FileEditor) loadFile() error {
 	if _, err := os.Stat(fe.filename); os.IsNotExist(err) {
-		log.Printf("INFO: File %s does not exist, creating new file", fe.filename)
+		logInfo(i18n.Tr("File %[1]s does not exist, creating new file", fe.filename))
 		return nil
 	}
 
@@ -51,7 +67,7 @@ func (fe *FileEditor) loadFile() error {
 		return fmt.Errorf("error reading file: %w", err)
 	}
 
-	log.Printf("INFO: Loaded %d lines from %s", len(fe.lines), fe.filename)
+	logInfo(i18n.Tr("Loaded %[1]d lines from %[2]s", len(fe.lines), fe.filename))
 	return nil
 }
 
@@ -74,7 +90,7 @@ func (fe *FileEditor) save() error {
 	}
 
 	fe.modified = false
-	log.Printf("INFO: Saved %d lines to %s", len(fe.lines), fe.filename)
+	logInfo(i18n.Tr("Saved %[1]d lines to %[2]s", len(fe.lines), fe.filename))
 	return nil
 }
 
@@ -93,8 +109,9 @@ func (fe *FileEditor) insertLine(lineNum int, text string) error {
 
 	index := lineNum - 1
 	fe.lines = append(fe.lines[:index], append([]string{text}, fe.lines[index:]...)...)
+	fe.pushUndo(undoOp{kind: opDeleteLines, lineNum: lineNum, count: 1})
 	fe.modified = true
-	log.Printf("INFO: Inserted line at %d", lineNum)
+	logInfo(i18n.Tr("Inserted line at %[1]d", lineNum))
 	return nil
 }
 
@@ -104,9 +121,11 @@ func (fe *FileEditor) deleteLine(lineNum int) error {
 	}
 
 	index := lineNum - 1
+	oldText := fe.lines[index]
 	fe.lines = append(fe.lines[:index], fe.lines[index+1:]...)
+	fe.pushUndo(undoOp{kind: opInsertLines, lineNum: lineNum, lines: []string{oldText}})
 	fe.modified = true
-	log.Printf("INFO: Deleted line %d", lineNum)
+	logInfo(i18n.Tr("Deleted line %[1]d", lineNum))
 	return nil
 }
 
@@ -115,9 +134,11 @@ func (fe *FileEditor) replaceLine(lineNum int, text string) error {
 		return fmt.Errorf("invalid line number: %d", lineNum)
 	}
 
+	oldText := fe.lines[lineNum-1]
 	fe.lines[lineNum-1] = text
+	fe.pushUndo(undoOp{kind: opReplaceLine, lineNum: lineNum, text: oldText})
 	fe.modified = true
-	log.Printf("INFO: Replaced line %d", lineNum)
+	logInfo(i18n.Tr("Replaced line %[1]d", lineNum))
 	return nil
 }
 
@@ -128,15 +149,18 @@ func (fe *FileEditor) searchAndReplace(pattern, replacement string, global bool)
 	}
 
 	count := 0
+	var batch []undoOp
 	for i, line := range fe.lines {
 		if global {
 			newLine := regex.ReplaceAllString(line, replacement)
 			if newLine != line {
+				batch = append(batch, undoOp{kind: opReplaceLine, lineNum: i + 1, text: line})
 				fe.lines[i] = newLine
 				count++
 			}
 		} else {
 			if regex.MatchString(line) {
+				batch = append(batch, undoOp{kind: opReplaceLine, lineNum: i + 1, text: line})
 				fe.lines[i] = regex.ReplaceAllString(line, replacement)
 				count++
 				break
@@ -145,8 +169,9 @@ func (fe *FileEditor) searchAndReplace(pattern, replacement string, global bool)
 	}
 
 	if count > 0 {
+		fe.pushUndo(undoOp{kind: opBatch, ops: batch})
 		fe.modified = true
-		log.Printf("INFO: Replaced %d occurrences", count)
+		logInfo(i18n.Tr("Replaced %[1]d occurrences", count))
 	}
 	return nil
 }
@@ -169,8 +194,9 @@ func (fe *FileEditor) search(pattern string) ([]int, error) {
 
 func (fe *FileEditor) appendLine(text string) {
 	fe.lines = append(fe.lines, text)
+	fe.pushUndo(undoOp{kind: opDeleteLines, lineNum: len(fe.lines), count: 1})
 	fe.modified = true
-	log.Printf("INFO: Appended line")
+	logInfo(i18n.Tr("Appended line"))
 }
 
 func (fe *FileEditor) copyLines(start, end int) ([]string, error) {
@@ -180,7 +206,7 @@ func (fe *FileEditor) copyLines(start, end int) ([]string, error) {
 
 	copied := make([]string, end-start+1)
 	copy(copied, fe.lines[start-1:end])
-	log.Printf("INFO: Copied lines %d-%d", start, end)
+	logInfo(i18n.Tr("Copied lines %[1]d-%[2]d", start, end))
 	return copied, nil
 }
 
@@ -191,13 +217,14 @@ func (fe *FileEditor) pasteLines(lineNum int, lines []string) error {
 
 	index := lineNum - 1
 	fe.lines = append(fe.lines[:index], append(lines, fe.lines[index:]...)...)
+	fe.pushUndo(undoOp{kind: opDeleteLines, lineNum: lineNum, count: len(lines)})
 	fe.modified = true
-	log.Printf("INFO: Pasted %d lines at line %d", len(lines), lineNum)
+	logInfo(i18n.Tr("Pasted %[1]d lines at line %[2]d", len(lines), lineNum))
 	return nil
 }
 
 func printUsage() {
-	fmt.Println(`
+	fmt.Println(i18n.Tr(`
 File Editor Tool - Production Grade File Operations
 
 USAGE:
@@ -214,36 +241,181 @@ COMMANDS:
     global <pattern> <repl>      - Replace all matches of pattern
     copy <start> <end>           - Copy lines to clipboard
     paste <line>                 - Paste clipboard at line
+    undo                         - Undo the last edit
+    redo                         - Redo the last undone edit
     save, w                      - Save file
     quit, q                      - Quit (warns if unsaved)
     help, h                      - Show this help
 
+FLAGS:
+    -script <file>                - Run commands from file instead of the REPL.
+                                     Same grammar as above, "#" starts a comment.
+                                     On any command failure the file's changes
+                                     are rewound and the tool exits non-zero.
+
 EXAMPLES:
     insert 5 "Hello World"       - Insert "Hello World" at line 5
     delete 10                    - Delete line 10
     search "func.*main"          - Find lines matching regex
     substitute "old" "new"       - Replace first "old" with "new"
     global "TODO" "DONE"         - Replace all "TODO" with "DONE"
-`)
+`))
+}
+
+// runCommand executes one already-tokenized command line against editor,
+// sharing the exact grammar between the interactive REPL and -script mode.
+// "quit"/"q" is handled by the caller, since the two modes prompt
+// differently (if at all) before exiting.
+func runCommand(editor *FileEditor, clipboard *[]string, parts []string) error {
+	command := parts[0]
+
+	switch command {
+	case "display", "d":
+		editor.display()
+
+	case "insert":
+		if len(parts) < 3 {
+			return fmt.Errorf("usage: insert <line> <text>")
+		}
+		lineNum, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid line number: %s", parts[1])
+		}
+		text := strings.Join(parts[2:], " ")
+		return editor.insertLine(lineNum, text)
+
+	case "delete":
+		if len(parts) != 2 {
+			return fmt.Errorf("usage: delete <line>")
+		}
+		lineNum, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid line number: %s", parts[1])
+		}
+		return editor.deleteLine(lineNum)
+
+	case "replace":
+		if len(parts) < 3 {
+			return fmt.Errorf("usage: replace <line> <text>")
+		}
+		lineNum, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid line number: %s", parts[1])
+		}
+		text := strings.Join(parts[2:], " ")
+		return editor.replaceLine(lineNum, text)
+
+	case "append":
+		if len(parts) < 2 {
+			return fmt.Errorf("usage: append <text>")
+		}
+		text := strings.Join(parts[1:], " ")
+		editor.appendLine(text)
+
+	case "search":
+		if len(parts) != 2 {
+			return fmt.Errorf("usage: search <pattern>")
+		}
+		matches, err := editor.search(parts[1])
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			fmt.Println(i18n.Tr("No matches found"))
+		} else {
+			fmt.Println(i18n.Tr("Found matches at lines: %[1]v", matches))
+		}
+
+	case "substitute":
+		if len(parts) != 3 {
+			return fmt.Errorf("usage: substitute <pattern> <replacement>")
+		}
+		return editor.searchAndReplace(parts[1], parts[2], false)
+
+	case "global":
+		if len(parts) != 3 {
+			return fmt.Errorf("usage: global <pattern> <replacement>")
+		}
+		return editor.searchAndReplace(parts[1], parts[2], true)
+
+	case "copy":
+		if len(parts) != 3 {
+			return fmt.Errorf("usage: copy <start> <end>")
+		}
+		start, err1 := strconv.Atoi(parts[1])
+		end, err2 := strconv.Atoi(parts[2])
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("invalid line numbers")
+		}
+		copied, err := editor.copyLines(start, end)
+		if err != nil {
+			return err
+		}
+		*clipboard = copied
+
+	case "paste":
+		if len(parts) != 2 {
+			return fmt.Errorf("usage: paste <line>")
+		}
+		if len(*clipboard) == 0 {
+			return fmt.Errorf("clipboard is empty")
+		}
+		lineNum, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid line number: %s", parts[1])
+		}
+		return editor.pasteLines(lineNum, *clipboard)
+
+	case "undo":
+		return editor.Undo()
+
+	case "redo":
+		return editor.Redo()
+
+	case "save", "w":
+		if err := editor.save(); err != nil {
+			return err
+		}
+		fmt.Println(i18n.Tr("File saved successfully"))
+
+	case "help", "h":
+		printUsage()
+
+	default:
+		return fmt.Errorf("unknown command: %s (type 'help' for commands)", command)
+	}
+
+	return nil
 }
 
 func main() {
-	if len(os.Args) != 2 {
-		fmt.Fprintf(os.Stderr, "ERROR: Usage: %s <filename>\n", os.Args[0])
+	scriptFile := flag.String("script", "", "run commands from file instead of the interactive REPL")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "ERROR: Usage: %s [-script <file>] <filename>\n", os.Args[0])
 		os.Exit(1)
 	}
 
-	filename := os.Args[1]
+	filename := flag.Arg(0)
 	editor, err := NewFileEditor(filename)
 	if err != nil {
 		log.Fatalf("ERROR: %v", err)
 	}
 
+	if *scriptFile != "" {
+		if err := runScript(editor, *scriptFile); err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var clipboard []string
 	scanner := bufio.NewScanner(os.Stdin)
 
-	fmt.Printf("File Editor - Editing: %s\n", filename)
-	fmt.Println("Type 'help' for commands")
+	fmt.Println(i18n.Tr("File Editor - Editing: %[1]s", filename))
+	fmt.Println(i18n.Tr("Type 'help' for commands"))
 
 	for {
 		fmt.Print("> ")
@@ -259,140 +431,7 @@ func main() {
 		parts := strings.Fields(input)
 		command := parts[0]
 
-		switch command {
-		case "display", "d":
-			editor.display()
-
-		case "insert":
-			if len(parts) < 3 {
-				fmt.Println("ERROR: Usage: insert <line> <text>")
-				continue
-			}
-			lineNum, err := strconv.Atoi(parts[1])
-			if err != nil {
-				fmt.Printf("ERROR: Invalid line number: %s\n", parts[1])
-				continue
-			}
-			text := strings.Join(parts[2:], " ")
-			if err := editor.insertLine(lineNum, text); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "delete":
-			if len(parts) != 2 {
-				fmt.Println("ERROR: Usage: delete <line>")
-				continue
-			}
-			lineNum, err := strconv.Atoi(parts[1])
-			if err != nil {
-				fmt.Printf("ERROR: Invalid line number: %s\n", parts[1])
-				continue
-			}
-			if err := editor.deleteLine(lineNum); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "replace":
-			if len(parts) < 3 {
-				fmt.Println("ERROR: Usage: replace <line> <text>")
-				continue
-			}
-			lineNum, err := strconv.Atoi(parts[1])
-			if err != nil {
-				fmt.Printf("ERROR: Invalid line number: %s\n", parts[1])
-				continue
-			}
-			text := strings.Join(parts[2:], " ")
-			if err := editor.replaceLine(lineNum, text); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "append":
-			if len(parts) < 2 {
-				fmt.Println("ERROR: Usage: append <text>")
-				continue
-			}
-			text := strings.Join(parts[1:], " ")
-			editor.appendLine(text)
-
-		case "search":
-			if len(parts) != 2 {
-				fmt.Println("ERROR: Usage: search <pattern>")
-				continue
-			}
-			matches, err := editor.search(parts[1])
-			if err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-				continue
-			}
-			if len(matches) == 0 {
-				fmt.Println("No matches found")
-			} else {
-				fmt.Printf("Found matches at lines: %v\n", matches)
-			}
-
-		case "substitute":
-			if len(parts) != 3 {
-				fmt.Println("ERROR: Usage: substitute <pattern> <replacement>")
-				continue
-			}
-			if err := editor.searchAndReplace(parts[1], parts[2], false); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "global":
-			if len(parts) != 3 {
-				fmt.Println("ERROR: Usage: global <pattern> <replacement>")
-				continue
-			}
-			if err := editor.searchAndReplace(parts[1], parts[2], true); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "copy":
-			if len(parts) != 3 {
-				fmt.Println("ERROR: Usage: copy <start> <end>")
-				continue
-			}
-			start, err1 := strconv.Atoi(parts[1])
-			end, err2 := strconv.Atoi(parts[2])
-			if err1 != nil || err2 != nil {
-				fmt.Println("ERROR: Invalid line numbers")
-				continue
-			}
-			copied, err := editor.copyLines(start, end)
-			if err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			} else {
-				clipboard = copied
-			}
-
-		case "paste":
-			if len(parts) != 2 {
-				fmt.Println("ERROR: Usage: paste <line>")
-				continue
-			}
-			if len(clipboard) == 0 {
-				fmt.Println("ERROR: Clipboard is empty")
-				continue
-			}
-			lineNum, err := strconv.Atoi(parts[1])
-			if err != nil {
-				fmt.Printf("ERROR: Invalid line number: %s\n", parts[1])
-				continue
-			}
-			if err := editor.pasteLines(lineNum, clipboard); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			}
-
-		case "save", "w":
-			if err := editor.save(); err != nil {
-				fmt.Printf("ERROR: %v\n", err)
-			} else {
-				fmt.Println("File saved successfully")
-			}
-
-		case "quit", "q":
+		if command == "quit" || command == "q" {
 			if editor.modified {
 				fmt.Print("File has unsaved changes. Save before quitting? (y/n): ")
 				if scanner.Scan() {
@@ -405,18 +444,83 @@ func main() {
 					}
 				}
 			}
-			fmt.Println("Goodbye!")
+			fmt.Println(i18n.Tr("Goodbye!"))
 			os.Exit(0)
+		}
 
-		case "help", "h":
-			printUsage()
-
-		default:
-			fmt.Printf("ERROR: Unknown command: %s (type 'help' for commands)\n", command)
+		if err := runCommand(editor, &clipboard, parts); err != nil {
+			fmt.Printf("ERROR: %v\n", err)
 		}
 	}
 
 	if err := scanner.Err(); err != nil {
 		log.Fatalf("ERROR: Input error: %v", err)
 	}
+}
+
+// runScript reads one command per line (same grammar as the REPL, with
+// "#" comments) from path and executes them transactionally: if any
+// command fails, the editor's lines and the on-disk file are restored to
+// their pre-script state and runScript returns an error. The in-memory
+// snapshot is used instead of the undo stack because pushUndo bounds the
+// stack at maxUndoHistory, which would silently truncate the rewind on a
+// long script; the on-disk snapshot is needed on top of that because a
+// script that runs "save"/"w" before a later command fails would
+// otherwise leave the already-written file out of sync with the rewound
+// in-memory lines.
+func runScript(editor *FileEditor, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("cannot open script: %w", err)
+	}
+	defer file.Close()
+
+	snapshot := append([]string{}, editor.lines...)
+	snapshotModified := editor.modified
+	onDisk, onDiskErr := os.ReadFile(editor.filename)
+	onDiskExisted := onDiskErr == nil
+
+	restore := func() error {
+		editor.lines = snapshot
+		editor.modified = snapshotModified
+		if onDiskExisted {
+			return os.WriteFile(editor.filename, onDisk, 0666)
+		}
+		if err := os.Remove(editor.filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	var clipboard []string
+
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.Fields(line)
+		command := parts[0]
+
+		if command == "quit" || command == "q" {
+			break
+		}
+
+		if err := runCommand(editor, &clipboard, parts); err != nil {
+			if rerr := restore(); rerr != nil {
+				return fmt.Errorf("line %d: %q: %w (additionally, failed to restore on-disk file: %v)", lineNum, line, err, rerr)
+			}
+			return fmt.Errorf("line %d: %q: %w", lineNum, line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		if rerr := restore(); rerr != nil {
+			return fmt.Errorf("error reading script: %w (additionally, failed to restore on-disk file: %v)", err, rerr)
+		}
+		return fmt.Errorf("error reading script: %w", err)
+	}
+	return nil
 }
\ No newline at end of file
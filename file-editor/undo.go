@@ -0,0 +1,105 @@
+package main
+
+import "errors"
+
+var (
+	errNothingToUndo = errors.New("nothing to undo")
+	errNothingToRedo = errors.New("nothing to redo")
+)
+
+// maxUndoHistory bounds how many operations are kept on the undo stack, so
+// memory stays bounded however many edits are made; the oldest operations
+// are dropped first.
+const maxUndoHistory = 200
+
+type opKind int
+
+const (
+	opInsertLines opKind = iota
+	opDeleteLines
+	opReplaceLine
+	opBatch
+)
+
+// undoOp is a small, reversible description of one editor mutation. Each
+// mutating method pushes the op that would undo it, rather than a
+// whole-file snapshot, so memory stays linear in edit count.
+type undoOp struct {
+	kind    opKind
+	lineNum int
+	count   int      // opDeleteLines: number of lines to remove starting at lineNum
+	lines   []string // opInsertLines: lines to insert starting at lineNum
+	text    string   // opReplaceLine: text to write at lineNum
+	ops     []undoOp // opBatch: sub-ops, applied from last to first
+}
+
+// pushUndo records op as the action needed to undo the mutation that just
+// happened, and clears the redo stack since it's now stale.
+func (fe *FileEditor) pushUndo(op undoOp) {
+	fe.undoStack = append(fe.undoStack, op)
+	if len(fe.undoStack) > maxUndoHistory {
+		fe.undoStack = fe.undoStack[len(fe.undoStack)-maxUndoHistory:]
+	}
+	fe.redoStack = nil
+}
+
+// applyOp performs op against fe.lines and returns the op that would undo
+// what it just did, so the same machinery drives both Undo and Redo.
+func (fe *FileEditor) applyOp(op undoOp) undoOp {
+	switch op.kind {
+	case opInsertLines:
+		index := op.lineNum - 1
+		inserted := append([]string{}, op.lines...)
+		fe.lines = append(fe.lines[:index], append(inserted, fe.lines[index:]...)...)
+		return undoOp{kind: opDeleteLines, lineNum: op.lineNum, count: len(op.lines)}
+
+	case opDeleteLines:
+		index := op.lineNum - 1
+		removed := append([]string{}, fe.lines[index:index+op.count]...)
+		fe.lines = append(fe.lines[:index], fe.lines[index+op.count:]...)
+		return undoOp{kind: opInsertLines, lineNum: op.lineNum, lines: removed}
+
+	case opReplaceLine:
+		index := op.lineNum - 1
+		old := fe.lines[index]
+		fe.lines[index] = op.text
+		return undoOp{kind: opReplaceLine, lineNum: op.lineNum, text: old}
+
+	case opBatch:
+		inverse := make([]undoOp, len(op.ops))
+		for i := len(op.ops) - 1; i >= 0; i-- {
+			inverse[i] = fe.applyOp(op.ops[i])
+		}
+		return undoOp{kind: opBatch, ops: inverse}
+	}
+	return undoOp{}
+}
+
+// Undo reverts the most recent mutation, moving its inverse onto the redo
+// stack.
+func (fe *FileEditor) Undo() error {
+	if len(fe.undoStack) == 0 {
+		return errNothingToUndo
+	}
+	op := fe.undoStack[len(fe.undoStack)-1]
+	fe.undoStack = fe.undoStack[:len(fe.undoStack)-1]
+
+	redo := fe.applyOp(op)
+	fe.redoStack = append(fe.redoStack, redo)
+	fe.modified = true
+	return nil
+}
+
+// Redo re-applies the most recently undone mutation.
+func (fe *FileEditor) Redo() error {
+	if len(fe.redoStack) == 0 {
+		return errNothingToRedo
+	}
+	op := fe.redoStack[len(fe.redoStack)-1]
+	fe.redoStack = fe.redoStack[:len(fe.redoStack)-1]
+
+	undo := fe.applyOp(op)
+	fe.undoStack = append(fe.undoStack, undo)
+	fe.modified = true
+	return nil
+}
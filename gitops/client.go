@@ -0,0 +1,341 @@
+// Package gitops is a small git automation library, factored out of the
+// interactive Git Operations Tool so other Go programs can drive the same
+// operations directly. A Client is configured with functional options
+// (following jiri's gitutil.Git/gitOpt pattern) and exposes one method per
+// operation, each returning a *GitError on failure.
+package gitops
+
+import (
+	"os"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdchunk"
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdobj"
+	"github.com/Charuchandra-12/my_random_scripts/gitops/gitlist"
+)
+
+// Client runs git commands against a single repository, with an optional
+// author/committer identity and a pluggable runner/logger.
+type Client struct {
+	rootDir       string
+	env           []string
+	authorDate    string
+	committerDate string
+	userName      string
+	userEmail     string
+	runner        cmdobj.CmdRunner
+	logger        Logger
+
+	branchLister *gitlist.BranchListBuilder
+	commitLister *gitlist.CommitListBuilder
+	stashLister  *gitlist.StashListBuilder
+	remoteLister *gitlist.RemoteListBuilder
+}
+
+// Option configures a Client. Options are applied in the order passed to New.
+type Option func(*Client)
+
+// WithRootDir sets the working directory git commands run in. The zero
+// value runs in the current process's working directory.
+func WithRootDir(dir string) Option {
+	return func(c *Client) { c.rootDir = dir }
+}
+
+// WithEnv appends extra "KEY=VALUE" entries to every command's environment.
+func WithEnv(env ...string) Option {
+	return func(c *Client) { c.env = append(c.env, env...) }
+}
+
+// WithAuthorDate sets GIT_AUTHOR_DATE on commits made through this Client.
+func WithAuthorDate(date string) Option {
+	return func(c *Client) { c.authorDate = date }
+}
+
+// WithCommitterDate sets GIT_COMMITTER_DATE on commits made through this Client.
+func WithCommitterDate(date string) Option {
+	return func(c *Client) { c.committerDate = date }
+}
+
+// WithUserName sets GIT_AUTHOR_NAME and GIT_COMMITTER_NAME on commits made
+// through this Client, rather than mutating user.name in git config.
+func WithUserName(name string) Option {
+	return func(c *Client) { c.userName = name }
+}
+
+// WithUserEmail sets GIT_AUTHOR_EMAIL and GIT_COMMITTER_EMAIL on commits
+// made through this Client, rather than mutating user.email in git config.
+func WithUserEmail(email string) Option {
+	return func(c *Client) { c.userEmail = email }
+}
+
+// WithRunner overrides the CmdRunner git commands are executed with, e.g.
+// with a cmdobj.FakeRunner in tests.
+func WithRunner(runner cmdobj.CmdRunner) Option {
+	return func(c *Client) { c.runner = runner }
+}
+
+// WithLogger overrides where progress and diagnostic messages are sent.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) { c.logger = logger }
+}
+
+// New builds a Client, defaulting to the real git binary and the console
+// logger until overridden by opts.
+func New(opts ...Option) *Client {
+	c := &Client{
+		runner: cmdobj.NewExecRunner(),
+		logger: NewConsoleLogger(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.branchLister = gitlist.NewBranchListBuilder(c.runner, c.rootDir, c.env...)
+	c.commitLister = gitlist.NewCommitListBuilder(c.runner, c.rootDir, c.env...)
+	c.stashLister = gitlist.NewStashListBuilder(c.runner, c.rootDir, c.env...)
+	c.remoteLister = gitlist.NewRemoteListBuilder(c.runner, c.rootDir, c.env...)
+	return c
+}
+
+// Runner returns the CmdRunner this Client executes git through, so callers
+// can drive lower-level packages (gitlist, patch) against the same
+// directory/runner configuration.
+func (c *Client) Runner() cmdobj.CmdRunner {
+	return c.runner
+}
+
+// Dir returns the working directory this Client runs git commands in, so
+// callers can drive lower-level packages (gitlist, patch) against the same
+// directory/runner configuration.
+func (c *Client) Dir() string {
+	return c.rootDir
+}
+
+// identityEnv returns the GIT_AUTHOR_*/GIT_COMMITTER_* env vars implied by
+// the identity options, so commits are attributed without ever touching
+// git config, matching jiri's model.
+func (c *Client) identityEnv() []string {
+	var env []string
+	if c.userName != "" {
+		env = append(env, "GIT_AUTHOR_NAME="+c.userName, "GIT_COMMITTER_NAME="+c.userName)
+	}
+	if c.userEmail != "" {
+		env = append(env, "GIT_AUTHOR_EMAIL="+c.userEmail, "GIT_COMMITTER_EMAIL="+c.userEmail)
+	}
+	if c.authorDate != "" {
+		env = append(env, "GIT_AUTHOR_DATE="+c.authorDate)
+	}
+	if c.committerDate != "" {
+		env = append(env, "GIT_COMMITTER_DATE="+c.committerDate)
+	}
+	return env
+}
+
+// run executes `git args...`, streaming output to the terminal, and wraps
+// any failure in a *GitError.
+func (c *Client) run(args ...string) error {
+	c.logger.Info("Executing: git %[1]s", joinArgs(args))
+
+	env := append(c.identityEnv(), c.env...)
+	obj := cmdobj.New(args...).WithDir(c.rootDir).WithEnv(env...).WithStream().Build()
+	res, err := c.runner.Run(obj)
+	if err != nil {
+		gitErr := &GitError{
+			Root:   c.rootDir,
+			Args:   args,
+			Stdout: res.Stdout,
+			Stderr: res.Stderr,
+			Err:    err,
+		}
+		c.logger.Error("Git command failed: %[1]v", err)
+		return gitErr
+	}
+
+	c.logger.Info("Command completed successfully")
+	return nil
+}
+
+func joinArgs(args []string) string {
+	out := ""
+	for i, a := range args {
+		if i > 0 {
+			out += " "
+		}
+		out += a
+	}
+	return out
+}
+
+// Clone clones url into dir. If dir is empty, git picks the directory name.
+func (c *Client) Clone(url, dir string) error {
+	if dir != "" {
+		return c.run("clone", url, dir)
+	}
+	return c.run("clone", url)
+}
+
+// Init initializes a repository at dir.
+func (c *Client) Init(dir string) error {
+	return c.run("init", dir)
+}
+
+// Add stages paths, chunking the invocation if the argument list would
+// exceed the OS command-line limit.
+func (c *Client) Add(paths ...string) error {
+	if len(paths) <= 1 {
+		return c.run(append([]string{"add"}, paths...)...)
+	}
+	return cmdchunk.Run([]string{"add"}, paths, cmdchunk.DefaultLimit(), func(args []string) error {
+		return c.run(args...)
+	})
+}
+
+// Commit commits the staged changes with message. Callers can use
+// IsNothingToCommit to distinguish an empty index from a real failure.
+func (c *Client) Commit(message string) error {
+	return c.run("commit", "-m", message)
+}
+
+// Push pushes branch to remote. If branch is empty, the current branch's
+// configured upstream is used. Callers can use IsNonFastForward to detect a
+// rejected push.
+func (c *Client) Push(remote, branch string) error {
+	if branch != "" {
+		return c.run("push", remote, branch)
+	}
+	return c.run("push", remote)
+}
+
+// Pull pulls branch from remote. If branch is empty, the current branch's
+// configured upstream is used.
+func (c *Client) Pull(remote, branch string) error {
+	if branch != "" {
+		return c.run("pull", remote, branch)
+	}
+	return c.run("pull", remote)
+}
+
+// CreateBranch creates a new branch named name, checking it out immediately
+// if checkout is true.
+func (c *Client) CreateBranch(name string, checkout bool) error {
+	if checkout {
+		return c.run("checkout", "-b", name)
+	}
+	return c.run("branch", name)
+}
+
+// SwitchBranch checks out an existing branch.
+func (c *Client) SwitchBranch(name string) error {
+	return c.run("checkout", name)
+}
+
+// Merge merges branch into the current branch. Callers can use
+// IsMergeConflict to detect a conflicted merge.
+func (c *Client) Merge(branch string) error {
+	return c.run("merge", branch)
+}
+
+// DeleteBranch deletes branch, forcing the deletion if force is true.
+func (c *Client) DeleteBranch(name string, force bool) error {
+	if force {
+		return c.run("branch", "-D", name)
+	}
+	return c.run("branch", "-d", name)
+}
+
+// Status streams `git status` output.
+func (c *Client) Status() error {
+	return c.run("status")
+}
+
+// Log returns the last n commits reachable from HEAD.
+func (c *Client) Log(n int) ([]gitlist.Commit, error) {
+	return c.commitLister.List(n)
+}
+
+// Branches returns every local branch.
+func (c *Client) Branches() ([]gitlist.Branch, error) {
+	return c.branchLister.List()
+}
+
+// Remotes returns every configured remote.
+func (c *Client) Remotes() ([]gitlist.Remote, error) {
+	return c.remoteLister.List()
+}
+
+// AddRemote adds a remote named name pointing at url.
+func (c *Client) AddRemote(name, url string) error {
+	return c.run("remote", "add", name, url)
+}
+
+// Fetch fetches from the default remote.
+func (c *Client) Fetch() error {
+	return c.run("fetch")
+}
+
+// Reset resets HEAD to commit, using mode ("soft", "mixed", or "hard"). Any
+// other mode falls back to a plain `git reset`.
+func (c *Client) Reset(mode, commit string) error {
+	if commit == "" {
+		commit = "HEAD"
+	}
+	switch mode {
+	case "soft":
+		return c.run("reset", "--soft", commit)
+	case "hard":
+		return c.run("reset", "--hard", commit)
+	default:
+		return c.run("reset", commit)
+	}
+}
+
+// Stash stashes the working tree, with an optional message.
+func (c *Client) Stash(message string) error {
+	if message != "" {
+		return c.run("stash", "push", "-m", message)
+	}
+	return c.run("stash")
+}
+
+// StashList returns every stash entry, most recent first.
+func (c *Client) StashList() ([]gitlist.StashEntry, error) {
+	return c.stashLister.List()
+}
+
+// ApplyStash applies the stash entry named ref without dropping it.
+func (c *Client) ApplyStash(ref string) error {
+	return c.run("stash", "apply", ref)
+}
+
+// DropStash drops the stash entry named ref.
+func (c *Client) DropStash(ref string) error {
+	return c.run("stash", "drop", ref)
+}
+
+// Diff streams the working tree diff.
+func (c *Client) Diff() error {
+	return c.run("diff")
+}
+
+// ApplyPatch applies patchText to the index via `git apply --cached`,
+// reversing it (to unstage rather than stage) if reverse is true.
+// --unidiff-zero is required because patch.PatchParser diffs with -U0
+// (zero context lines); git apply otherwise rejects zero-context hunks.
+func (c *Client) ApplyPatch(patchText string, reverse bool) error {
+	tmp, err := os.CreateTemp("", "gitops-*.patch")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(patchText); err != nil {
+		tmp.Close()
+		return err
+	}
+	tmp.Close()
+
+	args := []string{"apply", "--cached", "--unidiff-zero"}
+	if reverse {
+		args = append(args, "--reverse")
+	}
+	args = append(args, tmp.Name())
+	return c.run(args...)
+}
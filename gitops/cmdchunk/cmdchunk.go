@@ -0,0 +1,65 @@
+// Package cmdchunk splits a long list of variadic arguments (paths, in
+// practice) across multiple invocations of the same base command, so a
+// single `git add`/`git rm`/etc. call never exceeds the OS's command-line
+// length limit.
+package cmdchunk
+
+import "runtime"
+
+// Conservative per-OS command-line budgets in bytes. macOS's real ARG_MAX
+// is close to 128 KiB and Linux's is close to 2 MiB, but other environment
+// variables already eat into that budget, so these constants stay well
+// under the real ceiling. Windows's ~32 KiB limit leaves the least room.
+const (
+	LimitWindows = 8000
+	LimitDefault = 50000
+)
+
+// DefaultLimit returns the conservative argument-length budget for the
+// current OS.
+func DefaultLimit() int {
+	if runtime.GOOS == "windows" {
+		return LimitWindows
+	}
+	return LimitDefault
+}
+
+// Run packs variadic into successive invocations of run(append(base, chunk...)),
+// greedily filling each invocation up to limit bytes (each arg counted as
+// its length plus one byte of separator), running them sequentially and
+// stopping at the first failure.
+func Run(base []string, variadic []string, limit int, run func([]string) error) error {
+	baseLen := 0
+	for _, a := range base {
+		baseLen += len(a) + 1
+	}
+
+	var chunk []string
+	chunkLen := baseLen
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		args := make([]string, 0, len(base)+len(chunk))
+		args = append(args, base...)
+		args = append(args, chunk...)
+
+		err := run(args)
+		chunk = chunk[:0]
+		chunkLen = baseLen
+		return err
+	}
+
+	for _, arg := range variadic {
+		argLen := len(arg) + 1
+		if chunkLen+argLen > limit && len(chunk) > 0 {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		chunk = append(chunk, arg)
+		chunkLen += argLen
+	}
+	return flush()
+}
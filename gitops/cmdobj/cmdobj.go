@@ -0,0 +1,139 @@
+// Package cmdobj provides a small command-object layer around exec.Command
+// so git invocations can be built, run, and faked consistently across the
+// gitops tool.
+package cmdobj
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// CmdObj captures everything needed to run a single git command: the
+// arguments, the working directory, extra environment variables, and
+// whether output should also be streamed to the terminal as it runs.
+type CmdObj struct {
+	Args   []string
+	Dir    string
+	Env    []string
+	Stream bool
+}
+
+// CmdBuilder incrementally constructs a CmdObj.
+type CmdBuilder struct {
+	obj CmdObj
+}
+
+// New starts a builder for `git <args...>`.
+func New(args ...string) *CmdBuilder {
+	return &CmdBuilder{obj: CmdObj{Args: args}}
+}
+
+// WithDir sets the working directory the command runs in.
+func (b *CmdBuilder) WithDir(dir string) *CmdBuilder {
+	b.obj.Dir = dir
+	return b
+}
+
+// WithEnv appends extra "KEY=VALUE" entries to the command's environment.
+func (b *CmdBuilder) WithEnv(env ...string) *CmdBuilder {
+	b.obj.Env = append(b.obj.Env, env...)
+	return b
+}
+
+// WithStream marks the command's output as also going to the terminal
+// (in addition to being captured) instead of being captured silently.
+func (b *CmdBuilder) WithStream() *CmdBuilder {
+	b.obj.Stream = true
+	return b
+}
+
+// Build returns the constructed CmdObj.
+func (b *CmdBuilder) Build() *CmdObj {
+	return &b.obj
+}
+
+// Result holds the captured output of a finished command.
+type Result struct {
+	Stdout string
+	Stderr string
+}
+
+// CmdRunner executes a CmdObj and returns its captured output.
+type CmdRunner interface {
+	Run(obj *CmdObj) (Result, error)
+}
+
+// ExecRunner is the default CmdRunner, backed by os/exec.
+type ExecRunner struct{}
+
+// NewExecRunner returns a CmdRunner that shells out to the real git binary.
+func NewExecRunner() *ExecRunner {
+	return &ExecRunner{}
+}
+
+func (r *ExecRunner) Run(obj *CmdObj) (Result, error) {
+	cmd := exec.Command("git", obj.Args...)
+	cmd.Dir = obj.Dir
+	if len(obj.Env) > 0 {
+		cmd.Env = append(os.Environ(), obj.Env...)
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	if obj.Stream {
+		cmd.Stdout = io.MultiWriter(os.Stdout, &stdoutBuf)
+		cmd.Stderr = io.MultiWriter(os.Stderr, &stderrBuf)
+	} else {
+		cmd.Stdout = &stdoutBuf
+		cmd.Stderr = &stderrBuf
+	}
+
+	err := cmd.Run()
+	result := Result{Stdout: stdoutBuf.String(), Stderr: stderrBuf.String()}
+	return result, err
+}
+
+// FakeRunner is an in-memory CmdRunner for tests: it maps a joined args key
+// to a canned Result/error, so callers can exercise builders without
+// shelling out to git.
+type FakeRunner struct {
+	Responses map[string]Result
+	Errors    map[string]error
+	Calls     []*CmdObj
+}
+
+// NewFakeRunner returns an empty FakeRunner ready to have responses registered.
+func NewFakeRunner() *FakeRunner {
+	return &FakeRunner{
+		Responses: make(map[string]Result),
+		Errors:    make(map[string]error),
+	}
+}
+
+// Stub registers the Result (and optional error) to return for the given
+// args key, as produced by Key.
+func (f *FakeRunner) Stub(key string, result Result, err error) {
+	f.Responses[key] = result
+	if err != nil {
+		f.Errors[key] = err
+	}
+}
+
+func (f *FakeRunner) Run(obj *CmdObj) (Result, error) {
+	f.Calls = append(f.Calls, obj)
+	key := Key(obj.Args)
+	return f.Responses[key], f.Errors[key]
+}
+
+// Key joins args into the lookup key used by FakeRunner.
+func Key(args []string) string {
+	joined := ""
+	for i, a := range args {
+		if i > 0 {
+			joined += "\x1f"
+		}
+		joined += a
+	}
+	return joined
+}
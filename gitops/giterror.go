@@ -0,0 +1,58 @@
+package gitops
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GitError records everything needed to diagnose a failed git invocation:
+// the args that were run, the directory it ran in, and the captured
+// stdout/stderr, modeled on jiri's gitutil error type.
+type GitError struct {
+	Root   string
+	Args   []string
+	Stdout string
+	Stderr string
+	Err    error
+}
+
+func (e *GitError) Error() string {
+	return fmt.Sprintf("'git %s' failed in %s:\nstdout:\n%s\nstderr:\n%s\ncause: %v",
+		strings.Join(e.Args, " "), e.Root, e.Stdout, e.Stderr, e.Err)
+}
+
+func (e *GitError) Unwrap() error {
+	return e.Err
+}
+
+// IsNonFastForward reports whether a GitError was caused by a rejected
+// non-fast-forward push.
+func IsNonFastForward(err error) bool {
+	return matchesStderr(err, "non-fast-forward", "fetch first")
+}
+
+// IsMergeConflict reports whether a GitError was caused by a merge conflict.
+func IsMergeConflict(err error) bool {
+	return matchesStderr(err, "conflict", "automatic merge failed")
+}
+
+// IsNothingToCommit reports whether a GitError was caused by there being
+// no staged changes to commit.
+func IsNothingToCommit(err error) bool {
+	return matchesStderr(err, "nothing to commit", "nothing added to commit")
+}
+
+func matchesStderr(err error, substrings ...string) bool {
+	var gitErr *GitError
+	if !errors.As(err, &gitErr) {
+		return false
+	}
+	stderr := strings.ToLower(gitErr.Stderr)
+	for _, s := range substrings {
+		if strings.Contains(stderr, s) {
+			return true
+		}
+	}
+	return false
+}
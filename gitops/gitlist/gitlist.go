@@ -0,0 +1,193 @@
+// Package gitlist builds typed lists (branches, commits, stashes, remotes)
+// out of machine-readable git output, so the gitops menu can drive
+// interactive pickers instead of printing raw git text.
+package gitlist
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdobj"
+)
+
+const fieldSep = "\x00"
+
+// Branch is one line of `git branch --list`.
+type Branch struct {
+	Name     string
+	IsHead   bool
+	Upstream string
+	Commit   string
+}
+
+// BranchListBuilder lists local branches via a machine-readable format.
+type BranchListBuilder struct {
+	Runner cmdobj.CmdRunner
+	Dir    string
+	Env    []string
+}
+
+// NewBranchListBuilder returns a builder that runs git through runner in
+// dir, with env appended to the command's environment.
+func NewBranchListBuilder(runner cmdobj.CmdRunner, dir string, env ...string) *BranchListBuilder {
+	return &BranchListBuilder{Runner: runner, Dir: dir, Env: env}
+}
+
+// List returns every local branch.
+func (b *BranchListBuilder) List() ([]Branch, error) {
+	obj := cmdobj.New("branch", "--format=%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(objectname)").WithDir(b.Dir).WithEnv(b.Env...).Build()
+	res, err := b.Runner.Run(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var branches []Branch
+	for _, line := range splitNonEmpty(res.Stdout) {
+		fields := strings.Split(line, fieldSep)
+		if len(fields) != 4 {
+			continue
+		}
+		branches = append(branches, Branch{
+			IsHead:   fields[0] == "*",
+			Name:     fields[1],
+			Upstream: fields[2],
+			Commit:   fields[3],
+		})
+	}
+	return branches, nil
+}
+
+// Commit is one entry of `git log`.
+type Commit struct {
+	Hash    string
+	Subject string
+	Author  string
+	Time    int64
+}
+
+// CommitListBuilder lists recent commits via a machine-readable format.
+type CommitListBuilder struct {
+	Runner cmdobj.CmdRunner
+	Dir    string
+	Env    []string
+}
+
+// NewCommitListBuilder returns a builder that runs git through runner in
+// dir, with env appended to the command's environment.
+func NewCommitListBuilder(runner cmdobj.CmdRunner, dir string, env ...string) *CommitListBuilder {
+	return &CommitListBuilder{Runner: runner, Dir: dir, Env: env}
+}
+
+// List returns the last n commits reachable from HEAD.
+func (c *CommitListBuilder) List(n int) ([]Commit, error) {
+	obj := cmdobj.New("log", "--pretty=%H%x00%s%x00%an%x00%ct", "-n", strconv.Itoa(n)).WithDir(c.Dir).WithEnv(c.Env...).Build()
+	res, err := c.Runner.Run(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, line := range splitNonEmpty(res.Stdout) {
+		fields := strings.Split(line, fieldSep)
+		if len(fields) != 4 {
+			continue
+		}
+		ts, _ := strconv.ParseInt(fields[3], 10, 64)
+		commits = append(commits, Commit{
+			Hash:    fields[0],
+			Subject: fields[1],
+			Author:  fields[2],
+			Time:    ts,
+		})
+	}
+	return commits, nil
+}
+
+// StashEntry is one entry of `git stash list`.
+type StashEntry struct {
+	Ref     string
+	Message string
+}
+
+// StashListBuilder lists stash entries via a machine-readable format.
+type StashListBuilder struct {
+	Runner cmdobj.CmdRunner
+	Dir    string
+	Env    []string
+}
+
+// NewStashListBuilder returns a builder that runs git through runner in
+// dir, with env appended to the command's environment.
+func NewStashListBuilder(runner cmdobj.CmdRunner, dir string, env ...string) *StashListBuilder {
+	return &StashListBuilder{Runner: runner, Dir: dir, Env: env}
+}
+
+// List returns every stash entry, most recent first.
+func (s *StashListBuilder) List() ([]StashEntry, error) {
+	obj := cmdobj.New("stash", "list", "--pretty=%gd%x00%gs").WithDir(s.Dir).WithEnv(s.Env...).Build()
+	res, err := s.Runner.Run(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []StashEntry
+	for _, line := range splitNonEmpty(res.Stdout) {
+		fields := strings.Split(line, fieldSep)
+		if len(fields) != 2 {
+			continue
+		}
+		entries = append(entries, StashEntry{Ref: fields[0], Message: fields[1]})
+	}
+	return entries, nil
+}
+
+// Remote is one remote configured on the repository.
+type Remote struct {
+	Name string
+	URL  string
+}
+
+// RemoteListBuilder lists configured remotes.
+type RemoteListBuilder struct {
+	Runner cmdobj.CmdRunner
+	Dir    string
+	Env    []string
+}
+
+// NewRemoteListBuilder returns a builder that runs git through runner in
+// dir, with env appended to the command's environment.
+func NewRemoteListBuilder(runner cmdobj.CmdRunner, dir string, env ...string) *RemoteListBuilder {
+	return &RemoteListBuilder{Runner: runner, Dir: dir, Env: env}
+}
+
+// List returns each remote once, even though `git remote -v` prints a
+// fetch and a push line for it.
+func (r *RemoteListBuilder) List() ([]Remote, error) {
+	obj := cmdobj.New("remote", "-v").WithDir(r.Dir).WithEnv(r.Env...).Build()
+	res, err := r.Runner.Run(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var remotes []Remote
+	for _, line := range splitNonEmpty(res.Stdout) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || seen[fields[0]] {
+			continue
+		}
+		seen[fields[0]] = true
+		remotes = append(remotes, Remote{Name: fields[0], URL: fields[1]})
+	}
+	return remotes, nil
+}
+
+func splitNonEmpty(s string) []string {
+	var out []string
+	for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
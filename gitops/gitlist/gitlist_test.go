@@ -0,0 +1,143 @@
+package gitlist
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdobj"
+)
+
+func TestBranchListBuilder_List(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	args := []string{"branch", "--format=%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(objectname)"}
+	runner.Stub(cmdobj.Key(args), cmdobj.Result{Stdout: "" +
+		"*" + fieldSep + "main" + fieldSep + "origin/main" + fieldSep + "abc123\n" +
+		" " + fieldSep + "feature" + fieldSep + "" + fieldSep + "def456\n",
+	}, nil)
+
+	branches, err := NewBranchListBuilder(runner, "").List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []Branch{
+		{Name: "main", IsHead: true, Upstream: "origin/main", Commit: "abc123"},
+		{Name: "feature", IsHead: false, Upstream: "", Commit: "def456"},
+	}
+	if len(branches) != len(want) {
+		t.Fatalf("got %d branches, want %d", len(branches), len(want))
+	}
+	for i, b := range branches {
+		if b != want[i] {
+			t.Errorf("branch %d = %+v, want %+v", i, b, want[i])
+		}
+	}
+}
+
+func TestBranchListBuilder_List_skipsMalformedLines(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	args := []string{"branch", "--format=%(HEAD)%00%(refname:short)%00%(upstream:short)%00%(objectname)"}
+	runner.Stub(cmdobj.Key(args), cmdobj.Result{Stdout: "too" + fieldSep + "few\n"}, nil)
+
+	branches, err := NewBranchListBuilder(runner, "").List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(branches) != 0 {
+		t.Fatalf("got %d branches, want 0", len(branches))
+	}
+}
+
+func TestBranchListBuilder_List_usesConfiguredDirAndEnv(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	builder := NewBranchListBuilder(runner, "/repo/a", "GIT_SSH_COMMAND=ssh -i key")
+
+	if _, err := builder.List(); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if len(runner.Calls) != 1 {
+		t.Fatalf("got %d calls, want 1", len(runner.Calls))
+	}
+	call := runner.Calls[0]
+	if call.Dir != "/repo/a" {
+		t.Errorf("Dir = %q, want /repo/a", call.Dir)
+	}
+	if len(call.Env) != 1 || call.Env[0] != "GIT_SSH_COMMAND=ssh -i key" {
+		t.Errorf("Env = %v, want [GIT_SSH_COMMAND=ssh -i key]", call.Env)
+	}
+}
+
+func TestCommitListBuilder_List(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	n := 2
+	args := []string{"log", "--pretty=%H%x00%s%x00%an%x00%ct", "-n", strconv.Itoa(n)}
+	runner.Stub(cmdobj.Key(args), cmdobj.Result{Stdout: "" +
+		"deadbeef" + fieldSep + "Fix bug" + fieldSep + "Ada" + fieldSep + "1700000000\n" +
+		"feedface" + fieldSep + "Add feature" + fieldSep + "Bea" + fieldSep + "1699999999\n",
+	}, nil)
+
+	commits, err := NewCommitListBuilder(runner, "").List(n)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []Commit{
+		{Hash: "deadbeef", Subject: "Fix bug", Author: "Ada", Time: 1700000000},
+		{Hash: "feedface", Subject: "Add feature", Author: "Bea", Time: 1699999999},
+	}
+	if len(commits) != len(want) {
+		t.Fatalf("got %d commits, want %d", len(commits), len(want))
+	}
+	for i, c := range commits {
+		if c != want[i] {
+			t.Errorf("commit %d = %+v, want %+v", i, c, want[i])
+		}
+	}
+}
+
+func TestStashListBuilder_List(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	args := []string{"stash", "list", "--pretty=%gd%x00%gs"}
+	runner.Stub(cmdobj.Key(args), cmdobj.Result{Stdout: "" +
+		"stash@{0}" + fieldSep + "WIP on main: abc123 message\n",
+	}, nil)
+
+	entries, err := NewStashListBuilder(runner, "").List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []StashEntry{{Ref: "stash@{0}", Message: "WIP on main: abc123 message"}}
+	if len(entries) != len(want) || entries[0] != want[0] {
+		t.Fatalf("got %+v, want %+v", entries, want)
+	}
+}
+
+func TestRemoteListBuilder_List_dedupesFetchAndPush(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	args := []string{"remote", "-v"}
+	runner.Stub(cmdobj.Key(args), cmdobj.Result{Stdout: "" +
+		"origin\tgit@example.com:a/b.git (fetch)\n" +
+		"origin\tgit@example.com:a/b.git (push)\n" +
+		"upstream\thttps://example.com/a/b.git (fetch)\n",
+	}, nil)
+
+	remotes, err := NewRemoteListBuilder(runner, "").List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	want := []Remote{
+		{Name: "origin", URL: "git@example.com:a/b.git"},
+		{Name: "upstream", URL: "https://example.com/a/b.git"},
+	}
+	if len(remotes) != len(want) {
+		t.Fatalf("got %d remotes, want %d", len(remotes), len(want))
+	}
+	for i, r := range remotes {
+		if r != want[i] {
+			t.Errorf("remote %d = %+v, want %+v", i, r, want[i])
+		}
+	}
+}
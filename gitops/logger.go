@@ -0,0 +1,48 @@
+package gitops
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Charuchandra-12/my_random_scripts/i18n"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// Logger receives progress and diagnostic messages from a Client. format is
+// an i18n.Tr key, so callers embedding a Client in their own program can
+// supply a Logger that drops messages, forwards them to their own log
+// sink, or (the default) prints them the way the interactive tool always
+// has.
+type Logger interface {
+	Info(format string, args ...any)
+	Warn(format string, args ...any)
+	Error(format string, args ...any)
+}
+
+// consoleLogger is the default Logger, printing colored, timestamped,
+// translated lines to stdout exactly as the original interactive tool did.
+type consoleLogger struct{}
+
+// NewConsoleLogger returns the default Logger used when WithLogger is not
+// supplied.
+func NewConsoleLogger() Logger {
+	return consoleLogger{}
+}
+
+func (consoleLogger) Info(format string, args ...any) {
+	fmt.Printf("%s%s%s %s %s\n", colorGreen, i18n.Tr("[INFO]"), colorReset, time.Now().Format("15:04:05"), i18n.Tr(format, args...))
+}
+
+func (consoleLogger) Error(format string, args ...any) {
+	fmt.Printf("%s%s%s %s %s\n", colorRed, i18n.Tr("[ERROR]"), colorReset, time.Now().Format("15:04:05"), i18n.Tr(format, args...))
+}
+
+func (consoleLogger) Warn(format string, args ...any) {
+	fmt.Printf("%s%s%s %s %s\n", colorYellow, i18n.Tr("[WARN]"), colorReset, time.Now().Format("15:04:05"), i18n.Tr(format, args...))
+}
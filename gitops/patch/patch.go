@@ -0,0 +1,264 @@
+// Package patch implements line-level staging: parsing a unified diff into
+// hunks, dropping the lines a user didn't select, and recombining the
+// result into a patch that `git apply --cached` can consume.
+package patch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdobj"
+)
+
+// LineKind identifies a diff line's role within a hunk.
+type LineKind int
+
+const (
+	Context LineKind = iota
+	Add
+	Del
+)
+
+// Line is a single line of a hunk body.
+type Line struct {
+	Kind LineKind
+	Text string
+}
+
+// Hunk is one `@@ -a,b +c,d @@` block of a diff.
+type Hunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []Line
+}
+
+// FilePatch is the parsed diff for a single file.
+type FilePatch struct {
+	File  string
+	Hunks []Hunk
+}
+
+// PatchParser runs `git diff` for a path and parses the result into hunks.
+type PatchParser struct {
+	Runner cmdobj.CmdRunner
+	Dir    string
+}
+
+// NewPatchParser returns a parser that runs git through runner in dir, the
+// same directory Client.ApplyPatch applies the resulting patch in.
+func NewPatchParser(runner cmdobj.CmdRunner, dir string) *PatchParser {
+	return &PatchParser{Runner: runner, Dir: dir}
+}
+
+// Parse diffs path against the index (or, if cached is true, the index
+// against HEAD) and returns the parsed hunks.
+func (p *PatchParser) Parse(path string, cached bool) (*FilePatch, error) {
+	args := []string{"diff", "--no-color", "-U0"}
+	if cached {
+		args = append(args, "--cached")
+	}
+	args = append(args, "--", path)
+
+	res, err := p.Runner.Run(cmdobj.New(args...).WithDir(p.Dir).Build())
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnifiedDiff(path, res.Stdout)
+}
+
+// ParseUnifiedDiff parses the body of a `-U0` unified diff for a single file.
+func ParseUnifiedDiff(file, diff string) (*FilePatch, error) {
+	fp := &FilePatch{File: file}
+
+	var current *Hunk
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			hunk, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, err
+			}
+			fp.Hunks = append(fp.Hunks, *hunk)
+			current = &fp.Hunks[len(fp.Hunks)-1]
+		case strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---"):
+			// file header, not part of any hunk
+		case current == nil:
+			// preamble (diff --git, index, etc.)
+		case strings.HasPrefix(line, "+"):
+			current.Lines = append(current.Lines, Line{Kind: Add, Text: line[1:]})
+		case strings.HasPrefix(line, "-"):
+			current.Lines = append(current.Lines, Line{Kind: Del, Text: line[1:]})
+		case strings.HasPrefix(line, " "):
+			current.Lines = append(current.Lines, Line{Kind: Context, Text: line[1:]})
+		}
+	}
+	return fp, nil
+}
+
+func parseHunkHeader(line string) (*Hunk, error) {
+	// @@ -a[,b] +c[,d] @@
+	parts := strings.SplitN(line, "@@", 3)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("malformed hunk header: %q", line)
+	}
+	fields := strings.Fields(strings.TrimSpace(parts[1]))
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("malformed hunk header: %q", line)
+	}
+
+	oldStart, oldLines, err := parseRange(fields[0])
+	if err != nil {
+		return nil, err
+	}
+	newStart, newLines, err := parseRange(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	return &Hunk{OldStart: oldStart, OldLines: oldLines, NewStart: newStart, NewLines: newLines}, nil
+}
+
+func parseRange(field string) (start, count int, err error) {
+	field = strings.TrimLeft(field, "+-")
+	parts := strings.SplitN(field, ",", 2)
+	start, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed range %q: %w", field, err)
+	}
+	count = 1
+	if len(parts) == 2 {
+		count, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("malformed range %q: %w", field, err)
+		}
+	}
+	return start, count, nil
+}
+
+// PatchModifier rewrites a FilePatch so only the selected +/- lines survive;
+// the rest are dropped (adds) or turned back into context (dels), and empty
+// hunks are removed entirely.
+type PatchModifier struct{}
+
+// NewPatchModifier returns a PatchModifier.
+func NewPatchModifier() *PatchModifier {
+	return &PatchModifier{}
+}
+
+// Apply returns a new FilePatch containing only the changes whose index
+// (counting +/- lines across the whole file, in order) is in selected.
+func (m *PatchModifier) Apply(fp *FilePatch, selected map[int]bool) *FilePatch {
+	out := &FilePatch{File: fp.File}
+	changeIdx := 0
+
+	for _, h := range fp.Hunks {
+		var lines []Line
+		oldLines, newLines := 0, 0
+
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case Context:
+				lines = append(lines, l)
+				oldLines++
+				newLines++
+			case Add:
+				if selected[changeIdx] {
+					lines = append(lines, l)
+					newLines++
+				}
+				changeIdx++
+			case Del:
+				if selected[changeIdx] {
+					lines = append(lines, l)
+					oldLines++
+				} else {
+					// keep the old line as context so the patch still applies
+					lines = append(lines, Line{Kind: Context, Text: l.Text})
+					oldLines++
+					newLines++
+				}
+				changeIdx++
+			}
+		}
+
+		if !hunkHasChange(lines) {
+			continue
+		}
+		out.Hunks = append(out.Hunks, Hunk{
+			OldStart: h.OldStart,
+			OldLines: oldLines,
+			NewStart: h.NewStart,
+			NewLines: newLines,
+			Lines:    lines,
+		})
+	}
+	return out
+}
+
+func hunkHasChange(lines []Line) bool {
+	for _, l := range lines {
+		if l.Kind != Context {
+			return true
+		}
+	}
+	return false
+}
+
+// Render renders a FilePatch back into unified-diff text suitable for
+// `git apply`.
+func (fp *FilePatch) Render() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- a/%s\n", fp.File)
+	fmt.Fprintf(&b, "+++ b/%s\n", fp.File)
+
+	for _, h := range fp.Hunks {
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+		for _, l := range h.Lines {
+			switch l.Kind {
+			case Add:
+				b.WriteString("+" + l.Text + "\n")
+			case Del:
+				b.WriteString("-" + l.Text + "\n")
+			case Context:
+				b.WriteString(" " + l.Text + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// PatchManager accumulates per-file selections and produces a single
+// combined patch covering every staged/unstaged file.
+type PatchManager struct {
+	modifier *PatchModifier
+	patches  map[string]*FilePatch
+	order    []string
+}
+
+// NewPatchManager returns an empty PatchManager.
+func NewPatchManager() *PatchManager {
+	return &PatchManager{
+		modifier: NewPatchModifier(),
+		patches:  make(map[string]*FilePatch),
+	}
+}
+
+// Select records the chosen change indices for a file, overwriting any
+// prior selection for that file.
+func (pm *PatchManager) Select(fp *FilePatch, selected map[int]bool) {
+	if _, ok := pm.patches[fp.File]; !ok {
+		pm.order = append(pm.order, fp.File)
+	}
+	pm.patches[fp.File] = pm.modifier.Apply(fp, selected)
+}
+
+// Combined renders every selected file's patch as one patch document.
+func (pm *PatchManager) Combined() string {
+	var b strings.Builder
+	for _, file := range pm.order {
+		b.WriteString(pm.patches[file].Render())
+	}
+	return b.String()
+}
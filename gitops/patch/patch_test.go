@@ -0,0 +1,185 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/Charuchandra-12/my_random_scripts/gitops/cmdobj"
+)
+
+const sampleDiff = "diff --git a/f.txt b/f.txt\n" +
+	"index 111..222 100644\n" +
+	"--- a/f.txt\n" +
+	"+++ b/f.txt\n" +
+	"@@ -2,0 +3,2 @@\n" +
+	"+new1\n" +
+	"+new2\n" +
+	"@@ -5,2 +6,0 @@\n" +
+	"-old1\n" +
+	"-old2\n" +
+	"@@ -8 +7 @@\n" +
+	"-old\n" +
+	"+new\n"
+
+func TestPatchParser_Parse_usesConfiguredDir(t *testing.T) {
+	runner := cmdobj.NewFakeRunner()
+	runner.Stub(cmdobj.Key([]string{"diff", "--no-color", "-U0", "--", "f.txt"}), cmdobj.Result{Stdout: sampleDiff}, nil)
+
+	parser := NewPatchParser(runner, "/repo/a")
+	if _, err := parser.Parse("f.txt", false); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if len(runner.Calls) != 1 {
+		t.Fatalf("got %d calls, want 1", len(runner.Calls))
+	}
+	if got := runner.Calls[0].Dir; got != "/repo/a" {
+		t.Errorf("Dir = %q, want /repo/a", got)
+	}
+}
+
+func TestParseUnifiedDiff(t *testing.T) {
+	fp, err := ParseUnifiedDiff("f.txt", sampleDiff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff() error = %v", err)
+	}
+	if fp.File != "f.txt" {
+		t.Errorf("File = %q, want f.txt", fp.File)
+	}
+	if len(fp.Hunks) != 3 {
+		t.Fatalf("got %d hunks, want 3", len(fp.Hunks))
+	}
+
+	wantHunks := []Hunk{
+		{OldStart: 2, OldLines: 0, NewStart: 3, NewLines: 2, Lines: []Line{
+			{Kind: Add, Text: "new1"},
+			{Kind: Add, Text: "new2"},
+		}},
+		{OldStart: 5, OldLines: 2, NewStart: 6, NewLines: 0, Lines: []Line{
+			{Kind: Del, Text: "old1"},
+			{Kind: Del, Text: "old2"},
+		}},
+		{OldStart: 8, OldLines: 1, NewStart: 7, NewLines: 1, Lines: []Line{
+			{Kind: Del, Text: "old"},
+			{Kind: Add, Text: "new"},
+		}},
+	}
+	for i, want := range wantHunks {
+		got := fp.Hunks[i]
+		if got.OldStart != want.OldStart || got.OldLines != want.OldLines ||
+			got.NewStart != want.NewStart || got.NewLines != want.NewLines {
+			t.Errorf("hunk %d header = %+v, want %+v", i, got, want)
+		}
+		if len(got.Lines) != len(want.Lines) {
+			t.Fatalf("hunk %d has %d lines, want %d", i, len(got.Lines), len(want.Lines))
+		}
+		for j, l := range got.Lines {
+			if l != want.Lines[j] {
+				t.Errorf("hunk %d line %d = %+v, want %+v", i, j, l, want.Lines[j])
+			}
+		}
+	}
+}
+
+// TestPatchModifierApply checks the critical invariants: unselected adds are
+// dropped, unselected dels become context (so the patch still applies), hunk
+// order is preserved, and a hunk with nothing selected is omitted entirely.
+func TestPatchModifierApply(t *testing.T) {
+	fp, err := ParseUnifiedDiff("f.txt", sampleDiff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff() error = %v", err)
+	}
+
+	// Change indices in order: 0=new1, 1=new2, 2=old1, 3=old2, 4=old, 5=new.
+	// Select only "new2" (idx 1) and "old" (idx 4); hunk 1 (old1/old2) ends
+	// up with nothing selected and must be dropped.
+	selected := map[int]bool{1: true, 4: true}
+
+	out := NewPatchModifier().Apply(fp, selected)
+	if out.File != "f.txt" {
+		t.Errorf("File = %q, want f.txt", out.File)
+	}
+	if len(out.Hunks) != 2 {
+		t.Fatalf("got %d hunks, want 2 (the old1/old2 hunk should be dropped)", len(out.Hunks))
+	}
+
+	h0 := out.Hunks[0]
+	if h0.OldStart != 2 || h0.OldLines != 0 || h0.NewStart != 3 || h0.NewLines != 1 {
+		t.Errorf("hunk 0 header = %+v, want {OldStart:2 OldLines:0 NewStart:3 NewLines:1}", h0)
+	}
+	if len(h0.Lines) != 1 || h0.Lines[0] != (Line{Kind: Add, Text: "new2"}) {
+		t.Errorf("hunk 0 lines = %+v, want [{Add new2}]", h0.Lines)
+	}
+
+	h1 := out.Hunks[1]
+	if h1.OldStart != 8 || h1.OldLines != 1 || h1.NewStart != 7 || h1.NewLines != 0 {
+		t.Errorf("hunk 1 header = %+v, want {OldStart:8 OldLines:1 NewStart:7 NewLines:0}", h1)
+	}
+	if len(h1.Lines) != 1 || h1.Lines[0] != (Line{Kind: Del, Text: "old"}) {
+		t.Errorf("hunk 1 lines = %+v, want [{Del old}]", h1.Lines)
+	}
+}
+
+func TestPatchModifierApply_unselectedDelBecomesContext(t *testing.T) {
+	fp, err := ParseUnifiedDiff("f.txt", sampleDiff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff() error = %v", err)
+	}
+
+	// Select "old2" (idx 3) only, so "old1" (idx 2) in the same hunk must
+	// survive as context rather than being dropped, keeping the patch
+	// applicable.
+	out := NewPatchModifier().Apply(fp, map[int]bool{3: true})
+	if len(out.Hunks) != 1 {
+		t.Fatalf("got %d hunks, want 1", len(out.Hunks))
+	}
+
+	h := out.Hunks[0]
+	want := []Line{
+		{Kind: Context, Text: "old1"},
+		{Kind: Del, Text: "old2"},
+	}
+	if len(h.Lines) != len(want) {
+		t.Fatalf("lines = %+v, want %+v", h.Lines, want)
+	}
+	for i, l := range h.Lines {
+		if l != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, l, want[i])
+		}
+	}
+	if h.OldLines != 2 || h.NewLines != 1 {
+		t.Errorf("OldLines/NewLines = %d/%d, want 2/1", h.OldLines, h.NewLines)
+	}
+}
+
+func TestFilePatchRender(t *testing.T) {
+	fp, err := ParseUnifiedDiff("f.txt", sampleDiff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff() error = %v", err)
+	}
+
+	out := NewPatchModifier().Apply(fp, map[int]bool{1: true, 4: true})
+	got := out.Render()
+	want := "--- a/f.txt\n" +
+		"+++ b/f.txt\n" +
+		"@@ -2,0 +3,1 @@\n" +
+		"+new2\n" +
+		"@@ -8,1 +7,0 @@\n" +
+		"-old\n"
+
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestPatchManager_Combined(t *testing.T) {
+	fp, err := ParseUnifiedDiff("f.txt", sampleDiff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff() error = %v", err)
+	}
+
+	pm := NewPatchManager()
+	pm.Select(fp, map[int]bool{1: true, 4: true})
+	if got, want := pm.Combined(), NewPatchModifier().Apply(fp, map[int]bool{1: true, 4: true}).Render(); got != want {
+		t.Errorf("Combined() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+// Command extract walks the repository's Go source, finds every
+// i18n.Tr/i18n.TrN call whose key argument(s) are string literals, and
+// writes them out as a .pot template for translators. Only literal
+// arguments are extracted (the same contract i18n.Tr documents), so
+// calls built from fmt.Sprintf or other dynamic values are silently
+// skipped rather than attempted.
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	root := "."
+	if len(os.Args) > 1 {
+		root = os.Args[1]
+	}
+
+	keys := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		if strings.Contains(path, string(filepath.Separator)+"i18n"+string(filepath.Separator)) {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok || pkg.Name != "i18n" {
+				return true
+			}
+
+			switch sel.Sel.Name {
+			case "Tr":
+				if len(call.Args) >= 1 {
+					addLiteral(keys, call.Args[0])
+				}
+			case "TrN":
+				if len(call.Args) >= 2 {
+					addLiteral(keys, call.Args[0])
+					addLiteral(keys, call.Args[1])
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "extract: %v\n", err)
+		os.Exit(1)
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	fmt.Print(renderPOT(sorted))
+}
+
+func addLiteral(keys map[string]bool, expr ast.Expr) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+	keys[value] = true
+}
+
+func renderPOT(keys []string) string {
+	var b strings.Builder
+	b.WriteString("msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "msgid %s\nmsgstr \"\"\n\n", strconv.Quote(k))
+	}
+	return b.String()
+}
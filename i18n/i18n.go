@@ -0,0 +1,132 @@
+// Package i18n is a small gettext-style translation layer shared by the
+// gitops and file-editor tools. Catalogs are plain .po text files (no
+// compiled .mo support yet, to keep the format readable without extra
+// tooling); an English catalog is embedded as the default so the tools
+// work out of the box with no locale files installed.
+package i18n
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+//go:embed po/en.po
+var embedded embed.FS
+
+var catalog = loadCatalog()
+
+// Tr looks up key as a msgid in the active catalog and formats the result
+// with args. Message strings use positional verbs (%[1]s, %[2]d, ...) so
+// translators can reorder arguments freely. Only call sites passing a
+// string literal for key are visible to the po/default.pot extractor, so
+// never pass a pre-formatted string (e.g. via fmt.Sprintf) as key.
+func Tr(key string, args ...any) string {
+	format, ok := catalog[key]
+	if !ok {
+		format = key
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// TrN is like Tr, but picks the singular or plural msgid based on n.
+func TrN(key, pluralKey string, n int, args ...any) string {
+	if n == 1 {
+		return Tr(key, args...)
+	}
+	return Tr(pluralKey, args...)
+}
+
+// loadCatalog picks a catalog based on $LC_ALL/$LANG, falling back to the
+// embedded English default when no matching po/<lang>.po file exists
+// under the current working directory.
+func loadCatalog() map[string]string {
+	if lang := locale(); lang != "" && lang != "en" {
+		if data, err := os.ReadFile(filepath.Join("po", lang+".po")); err == nil {
+			if messages := parsePO(data); len(messages) > 0 {
+				return messages
+			}
+		}
+	}
+
+	data, err := embedded.ReadFile("po/en.po")
+	if err != nil {
+		return map[string]string{}
+	}
+	return parsePO(data)
+}
+
+func locale() string {
+	for _, env := range []string{"LC_ALL", "LANG"} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		if i := strings.IndexAny(v, "_."); i >= 0 {
+			v = v[:i]
+		}
+		return v
+	}
+	return "en"
+}
+
+// parsePO turns the msgid/msgstr pairs of a .po file into a lookup map.
+// Comments, headers, and empty msgids are ignored. An empty msgstr (a
+// legitimate, untranslated entry in a real .po file) is skipped too, so Tr
+// falls back to the msgid instead of rendering a blank string.
+func parsePO(data []byte) map[string]string {
+	messages := make(map[string]string)
+
+	var msgid, msgstr string
+	var inID, inStr bool
+
+	flush := func() {
+		if msgid != "" && msgstr != "" {
+			messages[msgid] = msgstr
+		}
+		msgid, msgstr = "", ""
+		inID, inStr = false, false
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			flush()
+		case strings.HasPrefix(line, "msgid "):
+			flush()
+			msgid = unquote(line[len("msgid "):])
+			inID = true
+		case strings.HasPrefix(line, "msgstr "):
+			msgstr = unquote(line[len("msgstr "):])
+			inStr = true
+			inID = false
+		case strings.HasPrefix(line, `"`):
+			switch {
+			case inID:
+				msgid += unquote(line)
+			case inStr:
+				msgstr += unquote(line)
+			}
+		}
+	}
+	flush()
+	return messages
+}
+
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return s
+	}
+	return unquoted
+}